@@ -0,0 +1,81 @@
+// Package localstore implements commentservice.AttachmentStore by writing
+// attachments to a directory on local disk, for development and small
+// single-node deployments that don't need a dedicated blob store.
+package localstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+type Config struct {
+	// Dir is the directory attachments are written to; it must already exist.
+	Dir string
+	// BaseURL is prefixed to an attachment's ID to build its URL, e.g.
+	// "https://uniclubs.example/attachments".
+	BaseURL string
+}
+
+// Store writes each attachment to Dir under its ID and serves it back at
+// BaseURL/<id>. Unlike a real object store, the URL isn't actually signed:
+// there's no expiry to enforce when the files sit on the same host serving
+// SignedURL's caller.
+type Store struct {
+	dir     string
+	baseURL string
+}
+
+func New(config Config) Store {
+	return Store{
+		dir:     config.Dir,
+		baseURL: config.BaseURL,
+	}
+}
+
+func (s Store) Upload(_ context.Context, id string, fileName string, content io.Reader, mimeType string, size int64) (domain.Attachment, error) {
+	f, err := os.Create(filepath.Join(s.dir, id))
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	defer f.Close()
+
+	written, err := io.Copy(f, content)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	if size > 0 && written != size {
+		return domain.Attachment{}, fmt.Errorf("localstore: wrote %d bytes, want %d", written, size)
+	}
+
+	return domain.Attachment{
+		ID:        id,
+		FileName:  fileName,
+		MimeType:  mimeType,
+		Size:      written,
+		URL:       s.url(id),
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (s Store) Delete(_ context.Context, attachmentID string) error {
+	err := os.Remove(filepath.Join(s.dir, attachmentID))
+	if errors.Is(err, os.ErrNotExist) {
+		return domain.ErrAttachmentNotFound
+	}
+	return err
+}
+
+func (s Store) SignedURL(_ context.Context, attachmentID string, _ time.Duration) (string, error) {
+	return s.url(attachmentID), nil
+}
+
+func (s Store) url(id string) string {
+	return s.baseURL + "/" + id
+}
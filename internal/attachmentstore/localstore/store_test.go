@@ -0,0 +1,52 @@
+package localstore
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+func TestStoreUploadDeleteRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	store := New(Config{Dir: dir, BaseURL: "https://uniclubs.example/attachments"})
+
+	attachment, err := store.Upload(context.Background(), "a1", "hello.txt", bytes.NewReader([]byte("hello")), "text/plain", 5)
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	if attachment.URL != "https://uniclubs.example/attachments/a1" {
+		t.Fatalf("URL = %q, want a base-url-prefixed URL", attachment.URL)
+	}
+	if attachment.FileName != "hello.txt" {
+		t.Fatalf("FileName = %q, want %q", attachment.FileName, "hello.txt")
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "a1")); err != nil {
+		t.Fatalf("expected file on disk: %v", err)
+	}
+
+	url, err := store.SignedURL(context.Background(), "a1", 0)
+	if err != nil || url != attachment.URL {
+		t.Fatalf("SignedURL() = (%q, %v), want (%q, nil)", url, err, attachment.URL)
+	}
+
+	if err := store.Delete(context.Background(), "a1"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "a1")); !os.IsNotExist(err) {
+		t.Fatal("expected file to be removed")
+	}
+}
+
+func TestStoreDeleteMissingReturnsAttachmentNotFound(t *testing.T) {
+	store := New(Config{Dir: t.TempDir()})
+
+	if err := store.Delete(context.Background(), "missing"); err != domain.ErrAttachmentNotFound {
+		t.Fatalf("Delete() error = %v, want ErrAttachmentNotFound", err)
+	}
+}
@@ -0,0 +1,177 @@
+package s3store
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	sigV4Algorithm = "AWS4-HMAC-SHA256"
+	sigV4Service   = "s3"
+	amzDateFormat  = "20060102T150405Z"
+	dateFormat     = "20060102"
+)
+
+// sign adds AWS Signature Version 4 headers to req, authorizing it against
+// this Store's bucket. body is the exact payload being sent (nil for a
+// bodyless request such as Delete).
+func (s Store) sign(req *http.Request, body []byte) {
+	now := time.Now().UTC()
+	amzDate := now.Format(amzDateFormat)
+	date := now.Format(dateFormat)
+	payloadHash := hashPayload(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if req.Host == "" {
+		req.Host = req.URL.Host
+	}
+
+	signedHeaders, canonicalHeaders := s.canonicalHeaders(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI(req.URL.Path),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := s.credentialScope(date)
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		amzDate,
+		scope,
+		hashString(canonicalRequest),
+	}, "\n")
+
+	signature := hex.EncodeToString(hmacSHA256(s.signingKey(date), stringToSign))
+
+	req.Header.Set("Authorization", sigV4Algorithm+" "+
+		"Credential="+s.accessKey+"/"+scope+", "+
+		"SignedHeaders="+signedHeaders+", "+
+		"Signature="+signature)
+}
+
+// presignGet builds a query-string-signed GET URL for attachmentID, valid
+// for ttl (AWS allows at most 7 days).
+func (s Store) presignGet(attachmentID string, ttl time.Duration) (string, error) {
+	now := time.Now().UTC()
+	amzDate := now.Format(amzDateFormat)
+	date := now.Format(dateFormat)
+	scope := s.credentialScope(date)
+
+	objectURL, err := url.Parse(s.objectURL(attachmentID))
+	if err != nil {
+		return "", err
+	}
+
+	query := objectURL.Query()
+	query.Set("X-Amz-Algorithm", sigV4Algorithm)
+	query.Set("X-Amz-Credential", s.accessKey+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(ttl.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+	objectURL.RawQuery = query.Encode()
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		canonicalURI(objectURL.Path),
+		canonicalQuery(objectURL.Query()),
+		"host:" + objectURL.Host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		amzDate,
+		scope,
+		hashString(canonicalRequest),
+	}, "\n")
+
+	signature := hex.EncodeToString(hmacSHA256(s.signingKey(date), stringToSign))
+
+	query = objectURL.Query()
+	query.Set("X-Amz-Signature", signature)
+	objectURL.RawQuery = query.Encode()
+
+	return objectURL.String(), nil
+}
+
+func (s Store) credentialScope(date string) string {
+	return date + "/" + s.region + "/" + sigV4Service + "/aws4_request"
+}
+
+func (s Store) signingKey(date string) []byte {
+	kDate := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	kRegion := hmacSHA256(kDate, s.region)
+	kService := hmacSHA256(kRegion, sigV4Service)
+	return hmacSHA256(kService, "aws4_request")
+}
+
+// canonicalHeaders returns the signed-headers list and canonical headers
+// block for req, always signing at least Host and X-Amz-Date.
+func (s Store) canonicalHeaders(req *http.Request) (signedHeaders, canonicalHeaders string) {
+	headers := map[string]string{
+		"host":                 req.Host,
+		"x-amz-content-sha256": req.Header.Get("X-Amz-Content-Sha256"),
+		"x-amz-date":           req.Header.Get("X-Amz-Date"),
+	}
+	if ct := req.Header.Get("Content-Type"); ct != "" {
+		headers["content-type"] = ct
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, name := range names {
+		canonical.WriteString(name)
+		canonical.WriteString(":")
+		canonical.WriteString(strings.TrimSpace(headers[name]))
+		canonical.WriteString("\n")
+	}
+
+	return strings.Join(names, ";"), canonical.String()
+}
+
+func canonicalURI(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
+func canonicalQuery(query url.Values) string {
+	return query.Encode()
+}
+
+func hashPayload(body []byte) string {
+	return hashBytes(body)
+}
+
+func hashString(s string) string {
+	return hashBytes([]byte(s))
+}
+
+func hashBytes(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
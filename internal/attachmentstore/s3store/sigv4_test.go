@@ -0,0 +1,60 @@
+package s3store
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testStore() Store {
+	return New(Config{
+		Endpoint:        "https://s3.us-east-1.amazonaws.com",
+		Region:          "us-east-1",
+		Bucket:          "uniclubs-attachments",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "secret",
+	})
+}
+
+// TestSignSetsAuthorizationHeader guards against sign silently producing a
+// request AWS would reject for a missing/malformed Authorization header.
+func TestSignSetsAuthorizationHeader(t *testing.T) {
+	s := testStore()
+
+	req, err := http.NewRequest(http.MethodPut, s.objectURL("a1"), strings.NewReader("hello"))
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	req.Header.Set("Content-Type", "text/plain")
+
+	s.sign(req, []byte("hello"))
+
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, sigV4Algorithm+" Credential=AKIDEXAMPLE/") {
+		t.Fatalf("Authorization = %q, want a SigV4 header for AKIDEXAMPLE", auth)
+	}
+	if !strings.Contains(auth, "SignedHeaders=") || !strings.Contains(auth, "Signature=") {
+		t.Fatalf("Authorization = %q, missing SignedHeaders/Signature", auth)
+	}
+	if req.Header.Get("X-Amz-Content-Sha256") == "" {
+		t.Fatal("expected X-Amz-Content-Sha256 to be set")
+	}
+}
+
+// TestPresignGetIncludesExpectedQueryParams guards against a presigned URL
+// missing the parameters S3 requires to validate it.
+func TestPresignGetIncludesExpectedQueryParams(t *testing.T) {
+	s := testStore()
+
+	signedURL, err := s.presignGet("a1", 15*time.Minute)
+	if err != nil {
+		t.Fatalf("presignGet() error = %v", err)
+	}
+
+	for _, want := range []string{"X-Amz-Algorithm=", "X-Amz-Credential=", "X-Amz-Expires=900", "X-Amz-Signature="} {
+		if !strings.Contains(signedURL, want) {
+			t.Fatalf("presigned URL %q missing %q", signedURL, want)
+		}
+	}
+}
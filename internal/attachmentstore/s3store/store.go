@@ -0,0 +1,123 @@
+// Package s3store implements commentservice.AttachmentStore against any
+// S3-compatible object store (AWS S3, MinIO, R2, ...), signing every
+// request with AWS Signature Version 4 using only the standard library.
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+type Config struct {
+	// Endpoint is the store's base URL, e.g.
+	// "https://s3.us-east-1.amazonaws.com" or a MinIO/R2 equivalent.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// HTTPClient defaults to http.DefaultClient when nil.
+	HTTPClient *http.Client
+}
+
+// Store implements commentservice.AttachmentStore against an S3-compatible
+// bucket addressed path-style: Endpoint/Bucket/key.
+type Store struct {
+	endpoint   string
+	region     string
+	bucket     string
+	accessKey  string
+	secretKey  string
+	httpClient *http.Client
+}
+
+func New(config Config) Store {
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return Store{
+		endpoint:   strings.TrimSuffix(config.Endpoint, "/"),
+		region:     config.Region,
+		bucket:     config.Bucket,
+		accessKey:  config.AccessKeyID,
+		secretKey:  config.SecretAccessKey,
+		httpClient: httpClient,
+	}
+}
+
+// Upload PUTs content to the bucket under id.
+func (s Store) Upload(ctx context.Context, id string, fileName string, content io.Reader, mimeType string, size int64) (domain.Attachment, error) {
+	body, err := io.ReadAll(content)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(id), bytes.NewReader(body))
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	req.Header.Set("Content-Type", mimeType)
+	req.ContentLength = int64(len(body))
+
+	s.sign(req, body)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return domain.Attachment{}, fmt.Errorf("s3store: upload failed with status %d", resp.StatusCode)
+	}
+
+	return domain.Attachment{
+		ID:        id,
+		FileName:  fileName,
+		MimeType:  mimeType,
+		Size:      int64(len(body)),
+		URL:       s.objectURL(id),
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// Delete removes an object from the bucket.
+func (s Store) Delete(ctx context.Context, attachmentID string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(attachmentID), nil)
+	if err != nil {
+		return err
+	}
+
+	s.sign(req, nil)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("s3store: delete failed with status %d", resp.StatusCode)
+	}
+}
+
+// SignedURL returns a presigned GET URL for the object, valid for ttl.
+func (s Store) SignedURL(_ context.Context, attachmentID string, ttl time.Duration) (string, error) {
+	return s.presignGet(attachmentID, ttl)
+}
+
+func (s Store) objectURL(key string) string {
+	return fmt.Sprintf("%s/%s/%s", s.endpoint, s.bucket, key)
+}
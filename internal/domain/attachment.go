@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// Attachment is a file uploaded alongside a comment. The blob itself lives in
+// whatever store is configured (S3, local disk, ...); URL is a link back to
+// it, typically short-lived and re-signed on read.
+type Attachment struct {
+	ID        string
+	CommentID string
+	FileName  string
+	MimeType  string
+	Size      int64
+	URL       string
+	CreatedAt time.Time
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// Comment is a single comment left on a post.
+type Comment struct {
+	ID        string
+	PostID    string
+	User      User
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// ParentID is the comment this one replies to, empty for top-level comments.
+	ParentID string
+	// RootID is the top-level comment of the thread, empty for top-level comments.
+	RootID string
+	// Path is a materialized path of ancestor IDs (e.g. "/root/parent/self") used
+	// to fetch a subtree without walking parent links.
+	Path string
+	// ChildCount is the number of direct replies to this comment.
+	ChildCount int
+	// Children holds replies loaded for this comment when a caller asks for a tree.
+	Children []Comment
+
+	// DeletedAt is set when the comment has been tombstoned rather than removed,
+	// which happens when a comment with replies is deleted.
+	DeletedAt *time.Time
+	// DeletedByModerator marks a tombstoned/deleted comment as removed by a
+	// moderator rather than its own author.
+	DeletedByModerator bool
+	// ModeratorID is the ID of the moderator who last updated or deleted this
+	// comment on the author's behalf, nil when the author acted on their own.
+	ModeratorID *int64
+
+	// Reactions is the per-emoji reaction count, hydrated in bulk alongside the
+	// comment listing rather than with one query per comment.
+	Reactions map[string]int
+	// MyReactions is the subset of emoji the requesting viewer has reacted
+	// with, empty when the listing was made without a viewer.
+	MyReactions []string
+
+	Attachments []Attachment
+
+	// Mentions are the users referenced via @handle in Body, resolved at
+	// write time.
+	Mentions []User
+
+	// Highlight is the matched snippet of Body for a full-text search result,
+	// empty outside of Filter.Query searches.
+	Highlight string
+}
+
+// User is the author of a comment.
+type User struct {
+	ID     int64
+	Name   string
+	Handle string
+
+	// ActorURL is the ActivityPub actor URL of a federated user, empty for a
+	// local one.
+	ActorURL string
+}
+
+// Sort orders the comments returned by ListPostComments.
+type Sort string
+
+const (
+	SortNew Sort = "new"
+	SortOld Sort = "old"
+	SortTop Sort = "top"
+)
+
+// Filter narrows down the comments returned by ListPostComments.
+type Filter struct {
+	Limit  int
+	Offset int
+
+	// TreeMode asks the provider to return top-level comments with their
+	// replies nested under Children, each paginated by ChildLimit.
+	TreeMode   bool
+	ChildLimit int
+
+	// Query full-text searches comment bodies, empty means no text filter.
+	Query string
+	// UserID restricts results to comments by a specific author, 0 means any.
+	UserID int64
+	// DateFrom/DateTo bound CreatedAt, zero value means unbounded on that side.
+	DateFrom time.Time
+	DateTo   time.Time
+	// Sort orders the results, empty defaults to SortNew.
+	Sort Sort
+	// Cursor resumes a previous cursor-based page and takes precedence over
+	// Offset when set, avoiding the skip-cost of a large offset.
+	Cursor string
+}
+
+// PaginationMetadata describes the page of results a listing query returned.
+type PaginationMetadata struct {
+	Total  int
+	Limit  int
+	Offset int
+	// NextCursor pages to the next result page without an offset, empty when
+	// there is no further page.
+	NextCursor string
+}
+
+// NewID generates a new random comment identifier.
+func NewID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidID       = errors.New("invalid id")
+	ErrInvalidArg      = errors.New("invalid argument")
+	ErrUserNotFound    = errors.New("user not found")
+	ErrCommentNotFound = errors.New("comment not found")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrInternal        = errors.New("internal error")
+
+	// ErrMaxDepthExceeded is returned when a reply would nest deeper than the
+	// configured maximum thread depth.
+	ErrMaxDepthExceeded = errors.New("max reply depth exceeded")
+
+	// ErrInvalidEmoji is returned when a reaction uses content outside the
+	// configured allowed emoji set.
+	ErrInvalidEmoji = errors.New("invalid emoji")
+
+	// ErrForbidden is returned when a viewer can see the post but lacks the
+	// role required to moderate a comment on it.
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound is returned for a viewer who can't see the post a comment
+	// belongs to, instead of a permission error, so existence isn't leaked.
+	ErrNotFound = errors.New("not found")
+
+	ErrAttachmentNotFound  = errors.New("attachment not found")
+	ErrAttachmentTooLarge  = errors.New("attachment too large")
+	ErrUnsupportedMimeType = errors.New("unsupported mime type")
+	ErrTooManyAttachments  = errors.New("too many attachments")
+)
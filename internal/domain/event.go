@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+// EventType names an outbound comment lifecycle event.
+type EventType string
+
+const (
+	EventCommentCreated   EventType = "comment.created"
+	EventCommentUpdated   EventType = "comment.updated"
+	EventCommentMentioned EventType = "comment.mentioned"
+	EventCommentDeleted   EventType = "comment.deleted"
+)
+
+// Event is a notification-worthy change to a comment, published after the
+// storage write that caused it has succeeded. ID lets consumers dedupe
+// redelivered events.
+type Event struct {
+	ID      string
+	Type    EventType
+	Comment Comment
+	// NotifiedUsers are the user IDs that should be notified about this event.
+	NotifiedUsers []int64
+	OccurredAt    time.Time
+}
@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// Reaction is a single user's emoji reaction to a comment. The
+// (CommentID, UserID, Content) triple is unique: a user can only react once
+// with a given emoji.
+type Reaction struct {
+	CommentID string
+	UserID    int64
+	Content   string
+	CreatedAt time.Time
+}
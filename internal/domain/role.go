@@ -0,0 +1,12 @@
+package domain
+
+// Role is the level of moderation authority a user has over a comment,
+// e.g. the club owner or an admin of the post it belongs to.
+type Role string
+
+const (
+	RoleNone      Role = ""
+	RoleModerator Role = "moderator"
+	RoleOwner     Role = "owner"
+	RoleAdmin     Role = "admin"
+)
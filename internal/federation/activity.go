@@ -0,0 +1,57 @@
+// Package federation maps local comments to ActivityStreams Notes and back,
+// so posts can be discussed from other ActivityPub servers.
+package federation
+
+import (
+	"time"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+// ActivityType is the ActivityStreams verb this subsystem handles.
+type ActivityType string
+
+const (
+	ActivityCreate ActivityType = "Create"
+	ActivityUpdate ActivityType = "Update"
+	ActivityDelete ActivityType = "Delete"
+)
+
+// Note is the ActivityStreams representation of a comment.
+type Note struct {
+	ID           string // ap_id, e.g. "https://uniclubs.example/comments/<id>"
+	AttributedTo string // actor URL of the author
+	Content      string
+	InReplyTo    string // parent Note's ap_id, empty for a top-level comment
+	Published    time.Time
+}
+
+// Activity wraps a Note with the verb applied to it and the actor performing it.
+type Activity struct {
+	ID     string // activity id, used to dedupe redelivered activities
+	Type   ActivityType
+	Actor  string
+	Object Note
+}
+
+// CommentToNote maps a local comment to the Note a remote server would see,
+// deriving a stable ap_id from baseURL so later Update/Delete activities for
+// it resolve back to the same comment.
+func CommentToNote(baseURL string, comment domain.Comment) Note {
+	return Note{
+		ID:           baseURL + "/comments/" + comment.ID,
+		AttributedTo: actorURL(baseURL, comment.User),
+		Content:      comment.Body,
+		Published:    comment.CreatedAt,
+	}
+}
+
+// actorURL returns user's ActivityPub actor URL, deriving one from baseURL
+// for a local user (ActorURL empty) so an outbound activity always has an
+// Actor to attach a signature to and for a remote server to resolve.
+func actorURL(baseURL string, user domain.User) string {
+	if user.ActorURL != "" {
+		return user.ActorURL
+	}
+	return baseURL + "/users/" + user.Handle
+}
@@ -0,0 +1,43 @@
+package federation
+
+import (
+	"testing"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+// TestCommentToNoteDerivesActorForLocalUser guards against a locally-authored
+// comment producing a Note with an empty AttributedTo: domain.User.ActorURL
+// is documented as empty for a local user, and EmitCreate is only ever
+// called for locally-created comments, so every outbound Create{Note} would
+// otherwise ship with no Actor to sign or resolve.
+func TestCommentToNoteDerivesActorForLocalUser(t *testing.T) {
+	comment := domain.Comment{
+		ID:   "c1",
+		User: domain.User{Handle: "alice"},
+	}
+
+	note := CommentToNote("https://uniclubs.example", comment)
+
+	if note.AttributedTo == "" {
+		t.Fatal("AttributedTo is empty for a locally-authored comment")
+	}
+	if want := "https://uniclubs.example/users/alice"; note.AttributedTo != want {
+		t.Fatalf("AttributedTo = %q, want %q", note.AttributedTo, want)
+	}
+}
+
+// TestCommentToNoteKeepsRemoteActor guards against overwriting a federated
+// user's existing ActorURL with a locally-derived one.
+func TestCommentToNoteKeepsRemoteActor(t *testing.T) {
+	comment := domain.Comment{
+		ID:   "c2",
+		User: domain.User{Handle: "bob", ActorURL: "https://remote.example/actor/bob"},
+	}
+
+	note := CommentToNote("https://uniclubs.example", comment)
+
+	if note.AttributedTo != "https://remote.example/actor/bob" {
+		t.Fatalf("AttributedTo = %q, want the remote ActorURL unchanged", note.AttributedTo)
+	}
+}
@@ -0,0 +1,210 @@
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/services/commentservice"
+	"github.com/ARUMANDESU/uniclubs-comments-service/pkg/logger"
+)
+
+// maxInboxBodyBytes caps a single inbound activity payload.
+const maxInboxBodyBytes = 10 << 20 // 10 MB
+
+var ErrUnknownActivity = errors.New("unknown activity type")
+
+// ErrActorNotAuthorized is returned when an activity's actor doesn't match
+// the author of the comment it targets.
+var ErrActorNotAuthorized = errors.New("federation: actor not authorized")
+
+// SignatureVerifier checks an inbound request's HTTP Signature against its
+// claimed actor's published key before the activity is trusted.
+type SignatureVerifier interface {
+	Verify(r *http.Request) error
+}
+
+type InboxConfig struct {
+	Logger   *slog.Logger
+	Verifier SignatureVerifier
+	Mapper   Mapper
+	Actors   ActorResolver
+	Provider commentservice.Provider
+	Creator  commentservice.Creator
+	Updater  commentservice.Updater
+	Deleter  commentservice.Deleter
+}
+
+// Inbox accepts signed Create/Update/Delete activities for Notes whose
+// inReplyTo targets a local post, turning them into local comments via the
+// existing Creator/Updater/Deleter paths.
+type Inbox struct {
+	log      *slog.Logger
+	verifier SignatureVerifier
+	mapper   Mapper
+	actors   ActorResolver
+	provider commentservice.Provider
+	creator  commentservice.Creator
+	updater  commentservice.Updater
+	deleter  commentservice.Deleter
+}
+
+func NewInbox(config InboxConfig) Inbox {
+	return Inbox{
+		log:      config.Logger,
+		verifier: config.Verifier,
+		mapper:   config.Mapper,
+		actors:   config.Actors,
+		provider: config.Provider,
+		creator:  config.Creator,
+		updater:  config.Updater,
+		deleter:  config.Deleter,
+	}
+}
+
+func (ib Inbox) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	const op = "federation.inbox.serve_http"
+	log := ib.log.With(slog.String("op", op))
+
+	if err := ib.verifier.Verify(r); err != nil {
+		log.Warn("rejected activity with invalid signature", logger.Err(err))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxInboxBodyBytes)
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	var activity Activity
+	if err := json.Unmarshal(body, &activity); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := ib.Handle(r.Context(), activity); err != nil {
+		log.Error(op, logger.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// Handle applies a verified activity, deduping redeliveries by activity ID.
+func (ib Inbox) Handle(ctx context.Context, activity Activity) error {
+	seen, err := ib.mapper.SeenActivity(ctx, activity.ID)
+	if err != nil {
+		return err
+	}
+	if seen {
+		return nil
+	}
+
+	switch activity.Type {
+	case ActivityCreate:
+		err = ib.handleCreate(ctx, activity)
+	case ActivityUpdate:
+		err = ib.handleUpdate(ctx, activity)
+	case ActivityDelete:
+		err = ib.handleDelete(ctx, activity)
+	default:
+		err = ErrUnknownActivity
+	}
+	if err != nil {
+		return err
+	}
+
+	return ib.mapper.MarkActivitySeen(ctx, activity.ID)
+}
+
+func (ib Inbox) handleCreate(ctx context.Context, activity Activity) error {
+	author, err := ib.actors.Resolve(ctx, activity.Actor)
+	if err != nil {
+		return err
+	}
+
+	var parentID, postID string
+	if activity.Object.InReplyTo != "" {
+		parentID, err = ib.mapper.CommentIDByAPID(ctx, activity.Object.InReplyTo)
+		switch {
+		case err == nil:
+			parent, err := ib.provider.GetComment(ctx, parentID)
+			if err != nil {
+				return err
+			}
+			postID = parent.PostID
+		case errors.Is(err, ErrMappingNotFound):
+			// Not a reply to a known comment — it must be a top-level reply
+			// to a local post instead.
+			postID, err = ib.mapper.PostIDByAPID(ctx, activity.Object.InReplyTo)
+			if err != nil {
+				return err
+			}
+		default:
+			return err
+		}
+	}
+
+	created, err := ib.creator.CreateComment(ctx, domain.Comment{
+		ID:        domain.NewID(),
+		PostID:    postID,
+		ParentID:  parentID,
+		User:      author,
+		Body:      activity.Object.Content,
+		CreatedAt: activity.Object.Published,
+		UpdatedAt: activity.Object.Published,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ib.mapper.SaveMapping(ctx, activity.Object.ID, created.ID)
+}
+
+func (ib Inbox) handleUpdate(ctx context.Context, activity Activity) error {
+	commentID, err := ib.mapper.CommentIDByAPID(ctx, activity.Object.ID)
+	if err != nil {
+		return err
+	}
+
+	comment, err := ib.provider.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.User.ActorURL == "" || comment.User.ActorURL != activity.Actor {
+		return ErrActorNotAuthorized
+	}
+
+	comment.Body = activity.Object.Content
+	comment.UpdatedAt = activity.Object.Published
+
+	_, err = ib.updater.UpdateComment(ctx, comment)
+	return err
+}
+
+func (ib Inbox) handleDelete(ctx context.Context, activity Activity) error {
+	commentID, err := ib.mapper.CommentIDByAPID(ctx, activity.Object.ID)
+	if err != nil {
+		return err
+	}
+
+	comment, err := ib.provider.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.User.ActorURL == "" || comment.User.ActorURL != activity.Actor {
+		return ErrActorNotAuthorized
+	}
+
+	return ib.deleter.DeleteComment(ctx, commentID)
+}
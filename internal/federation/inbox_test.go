@@ -0,0 +1,235 @@
+package federation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+type fakeMapper struct {
+	commentByAPID map[string]string
+	postByAPID    map[string]string
+	created       map[string]string
+	seen          map[string]bool
+}
+
+func newFakeMapper() *fakeMapper {
+	return &fakeMapper{
+		commentByAPID: map[string]string{},
+		postByAPID:    map[string]string{},
+		created:       map[string]string{},
+		seen:          map[string]bool{},
+	}
+}
+
+func (m *fakeMapper) CommentIDByAPID(_ context.Context, apID string) (string, error) {
+	id, ok := m.commentByAPID[apID]
+	if !ok {
+		return "", ErrMappingNotFound
+	}
+	return id, nil
+}
+
+func (m *fakeMapper) APIDByCommentID(_ context.Context, commentID string) (string, error) {
+	for apID, id := range m.commentByAPID {
+		if id == commentID {
+			return apID, nil
+		}
+	}
+	return "", ErrMappingNotFound
+}
+
+func (m *fakeMapper) SaveMapping(_ context.Context, apID, commentID string) error {
+	m.created[apID] = commentID
+	return nil
+}
+
+func (m *fakeMapper) PostIDByAPID(_ context.Context, apID string) (string, error) {
+	id, ok := m.postByAPID[apID]
+	if !ok {
+		return "", ErrMappingNotFound
+	}
+	return id, nil
+}
+
+func (m *fakeMapper) SeenActivity(_ context.Context, activityID string) (bool, error) {
+	return m.seen[activityID], nil
+}
+
+func (m *fakeMapper) MarkActivitySeen(_ context.Context, activityID string) error {
+	m.seen[activityID] = true
+	return nil
+}
+
+type fakeActorResolver struct {
+	user domain.User
+}
+
+func (r fakeActorResolver) Resolve(_ context.Context, actorURL string) (domain.User, error) {
+	user := r.user
+	user.ActorURL = actorURL
+	return user, nil
+}
+
+type fakeProvider struct {
+	comments map[string]domain.Comment
+}
+
+func (p *fakeProvider) GetComment(_ context.Context, commentID string) (domain.Comment, error) {
+	c, ok := p.comments[commentID]
+	if !ok {
+		return domain.Comment{}, domain.ErrCommentNotFound
+	}
+	return c, nil
+}
+
+func (p *fakeProvider) ListPostComments(context.Context, string, int64, domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error) {
+	return nil, domain.PaginationMetadata{}, nil
+}
+
+func (p *fakeProvider) ListReplies(context.Context, string, int64, domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error) {
+	return nil, domain.PaginationMetadata{}, nil
+}
+
+type fakeCreator struct {
+	created domain.Comment
+}
+
+func (c *fakeCreator) CreateComment(_ context.Context, comment domain.Comment) (domain.Comment, error) {
+	comment.ID = "new-comment"
+	c.created = comment
+	return comment, nil
+}
+
+type fakeUpdater struct {
+	updated domain.Comment
+}
+
+func (u *fakeUpdater) UpdateComment(_ context.Context, comment domain.Comment) (domain.Comment, error) {
+	u.updated = comment
+	return comment, nil
+}
+
+type fakeDeleter struct {
+	deletedID string
+}
+
+func (d *fakeDeleter) DeleteComment(_ context.Context, commentID string) error {
+	d.deletedID = commentID
+	return nil
+}
+
+func (d *fakeDeleter) TombstoneComment(_ context.Context, commentID string, _ *int64) error {
+	d.deletedID = commentID
+	return nil
+}
+
+// TestHandleCreateResolvesPostIDForReplyToPost guards against a top-level
+// federated reply to a post (rather than to another comment) being created
+// with an empty PostID.
+func TestHandleCreateResolvesPostIDForReplyToPost(t *testing.T) {
+	mapper := newFakeMapper()
+	mapper.postByAPID["https://remote.example/posts/p1"] = "post-1"
+	creator := &fakeCreator{}
+
+	ib := NewInbox(InboxConfig{
+		Mapper:   mapper,
+		Actors:   fakeActorResolver{},
+		Provider: &fakeProvider{comments: map[string]domain.Comment{}},
+		Creator:  creator,
+	})
+
+	err := ib.handleCreate(context.Background(), Activity{
+		ID:     "act-1",
+		Type:   ActivityCreate,
+		Actor:  "https://remote.example/actor/alice",
+		Object: Note{ID: "https://remote.example/notes/n1", InReplyTo: "https://remote.example/posts/p1", Published: time.Unix(0, 0)},
+	})
+	if err != nil {
+		t.Fatalf("handleCreate() error = %v", err)
+	}
+
+	if creator.created.PostID != "post-1" {
+		t.Fatalf("PostID = %q, want %q", creator.created.PostID, "post-1")
+	}
+}
+
+// TestHandleCreateResolvesPostIDForReplyToComment guards against a reply to
+// an existing federated comment losing track of which post it belongs to.
+func TestHandleCreateResolvesPostIDForReplyToComment(t *testing.T) {
+	mapper := newFakeMapper()
+	mapper.commentByAPID["https://remote.example/notes/parent"] = "parent-id"
+	creator := &fakeCreator{}
+
+	ib := NewInbox(InboxConfig{
+		Mapper:   mapper,
+		Actors:   fakeActorResolver{},
+		Provider: &fakeProvider{comments: map[string]domain.Comment{"parent-id": {ID: "parent-id", PostID: "post-2"}}},
+		Creator:  creator,
+	})
+
+	err := ib.handleCreate(context.Background(), Activity{
+		ID:     "act-2",
+		Type:   ActivityCreate,
+		Actor:  "https://remote.example/actor/alice",
+		Object: Note{ID: "https://remote.example/notes/n2", InReplyTo: "https://remote.example/notes/parent", Published: time.Unix(0, 0)},
+	})
+	if err != nil {
+		t.Fatalf("handleCreate() error = %v", err)
+	}
+
+	if creator.created.PostID != "post-2" {
+		t.Fatalf("PostID = %q, want %q", creator.created.PostID, "post-2")
+	}
+	if creator.created.ParentID != "parent-id" {
+		t.Fatalf("ParentID = %q, want %q", creator.created.ParentID, "parent-id")
+	}
+}
+
+// TestHandleUpdateRejectsNonAuthorActor guards against a remote actor who
+// merely guesses a comment's ap_id being able to edit someone else's comment.
+func TestHandleUpdateRejectsNonAuthorActor(t *testing.T) {
+	mapper := newFakeMapper()
+	mapper.commentByAPID["https://remote.example/notes/n1"] = "c1"
+	updater := &fakeUpdater{}
+
+	ib := NewInbox(InboxConfig{
+		Mapper:   mapper,
+		Provider: &fakeProvider{comments: map[string]domain.Comment{"c1": {ID: "c1", User: domain.User{ActorURL: "https://remote.example/actor/alice"}}}},
+		Updater:  updater,
+	})
+
+	err := ib.handleUpdate(context.Background(), Activity{
+		Actor:  "https://remote.example/actor/mallory",
+		Object: Note{ID: "https://remote.example/notes/n1", Content: "hijacked"},
+	})
+	if err != ErrActorNotAuthorized {
+		t.Fatalf("handleUpdate() error = %v, want ErrActorNotAuthorized", err)
+	}
+}
+
+// TestHandleDeleteRejectsNonAuthorActor mirrors the Update check for Delete.
+func TestHandleDeleteRejectsNonAuthorActor(t *testing.T) {
+	mapper := newFakeMapper()
+	mapper.commentByAPID["https://remote.example/notes/n1"] = "c1"
+	deleter := &fakeDeleter{}
+
+	ib := NewInbox(InboxConfig{
+		Mapper:   mapper,
+		Provider: &fakeProvider{comments: map[string]domain.Comment{"c1": {ID: "c1", User: domain.User{ActorURL: "https://remote.example/actor/alice"}}}},
+		Deleter:  deleter,
+	})
+
+	err := ib.handleDelete(context.Background(), Activity{
+		Actor:  "https://remote.example/actor/mallory",
+		Object: Note{ID: "https://remote.example/notes/n1"},
+	})
+	if err != ErrActorNotAuthorized {
+		t.Fatalf("handleDelete() error = %v, want ErrActorNotAuthorized", err)
+	}
+	if deleter.deletedID != "" {
+		t.Fatal("DeleteComment was called despite the actor mismatch")
+	}
+}
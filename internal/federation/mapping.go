@@ -0,0 +1,39 @@
+package federation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+// ErrMappingNotFound is returned by a Mapper lookup when no mapping exists
+// for the given ap_id, distinguishing "not known" from a backing-store error.
+var ErrMappingNotFound = errors.New("federation: mapping not found")
+
+// Mapper persists the ap_id <-> local comment ID mapping needed to turn a
+// remote Update/Delete into the right local comment, and tracks which
+// activity IDs have already been processed. It also resolves a post's ap_id
+// to its local PostID, so a top-level reply to a post (rather than to
+// another comment) can be attached to the right post.
+type Mapper interface {
+	// CommentIDByAPID returns ErrMappingNotFound if apID isn't a known local
+	// comment.
+	CommentIDByAPID(ctx context.Context, apID string) (string, error)
+	APIDByCommentID(ctx context.Context, commentID string) (string, error)
+	SaveMapping(ctx context.Context, apID, commentID string) error
+
+	// PostIDByAPID resolves a local post's ap_id to its PostID, returning
+	// ErrMappingNotFound if apID isn't a known local post.
+	PostIDByAPID(ctx context.Context, apID string) (string, error)
+
+	SeenActivity(ctx context.Context, activityID string) (bool, error)
+	MarkActivitySeen(ctx context.Context, activityID string) error
+}
+
+// ActorResolver resolves a remote actor URL into a domain.User, synthesizing
+// one with ActorURL set when the actor isn't known locally yet, so
+// UserProvider-style lookups elsewhere don't fail on a federated author.
+type ActorResolver interface {
+	Resolve(ctx context.Context, actorURL string) (domain.User, error)
+}
@@ -0,0 +1,85 @@
+package federation
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+	"github.com/ARUMANDESU/uniclubs-comments-service/pkg/logger"
+)
+
+// FollowerStore resolves the inbox URLs following a post's actor.
+type FollowerStore interface {
+	InboxURLsForPost(ctx context.Context, postID string) ([]string, error)
+}
+
+// Deliverer signs an activity with HTTP Signatures and delivers it to a
+// remote inbox.
+type Deliverer interface {
+	Deliver(ctx context.Context, inboxURL string, activity Activity) error
+}
+
+type OutboxConfig struct {
+	Logger    *slog.Logger
+	BaseURL   string
+	Followers FollowerStore
+	Mapper    Mapper
+	Deliverer Deliverer
+}
+
+// Outbox emits a Create{Note} activity to a post's followers whenever a
+// local comment is created.
+type Outbox struct {
+	log       *slog.Logger
+	baseURL   string
+	followers FollowerStore
+	mapper    Mapper
+	deliverer Deliverer
+}
+
+func NewOutbox(config OutboxConfig) Outbox {
+	return Outbox{
+		log:       config.Logger,
+		baseURL:   config.BaseURL,
+		followers: config.Followers,
+		mapper:    config.Mapper,
+		deliverer: config.Deliverer,
+	}
+}
+
+// EmitCreate builds a Create{Note} activity for comment and delivers it to
+// every inbox following the post's actor. A reply sets inReplyTo to the
+// parent's ap_id, reusing the comment service's threading model.
+func (ob Outbox) EmitCreate(ctx context.Context, comment domain.Comment) error {
+	const op = "federation.outbox.emit_create"
+	log := ob.log.With(slog.String("op", op))
+
+	note := CommentToNote(ob.baseURL, comment)
+	if comment.ParentID != "" {
+		parentAPID, err := ob.mapper.APIDByCommentID(ctx, comment.ParentID)
+		if err != nil {
+			return err
+		}
+		note.InReplyTo = parentAPID
+	}
+
+	activity := Activity{
+		ID:     domain.NewID(),
+		Type:   ActivityCreate,
+		Actor:  note.AttributedTo,
+		Object: note,
+	}
+
+	inboxURLs, err := ob.followers.InboxURLsForPost(ctx, comment.PostID)
+	if err != nil {
+		return err
+	}
+
+	for _, inboxURL := range inboxURLs {
+		if err := ob.deliverer.Deliver(ctx, inboxURL, activity); err != nil {
+			log.Error("failed to deliver activity", logger.Err(err), slog.String("inbox", inboxURL))
+		}
+	}
+
+	return ob.mapper.SaveMapping(ctx, note.ID, comment.ID)
+}
@@ -0,0 +1,113 @@
+// Package reactionapi exposes commentservice's reaction methods over HTTP.
+package reactionapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+	"github.com/ARUMANDESU/uniclubs-comments-service/pkg/logger"
+)
+
+// Service is the subset of commentservice.Service this handler drives.
+type Service interface {
+	AddReaction(ctx context.Context, commentID string, userID int64, emoji string) error
+	RemoveReaction(ctx context.Context, commentID string, userID int64, emoji string) error
+	ListReactions(ctx context.Context, commentID string, viewerID int64) ([]domain.Reaction, error)
+}
+
+type Handler struct {
+	log     *slog.Logger
+	service Service
+}
+
+func NewHandler(log *slog.Logger, service Service) Handler {
+	return Handler{log: log, service: service}
+}
+
+type reactionRequest struct {
+	CommentID string `json:"comment_id"`
+	UserID    int64  `json:"user_id"`
+	Emoji     string `json:"emoji"`
+}
+
+// Add handles POST /reactions, adding the caller's emoji reaction to a comment.
+func (h Handler) Add(w http.ResponseWriter, r *http.Request) {
+	const op = "reactionapi.add"
+	log := h.log.With(slog.String("op", op))
+
+	var req reactionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.AddReaction(r.Context(), req.CommentID, req.UserID, req.Emoji); err != nil {
+		writeErr(w, log, op, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Remove handles DELETE /reactions, withdrawing the caller's emoji reaction.
+func (h Handler) Remove(w http.ResponseWriter, r *http.Request) {
+	const op = "reactionapi.remove"
+	log := h.log.With(slog.String("op", op))
+
+	var req reactionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.RemoveReaction(r.Context(), req.CommentID, req.UserID, req.Emoji); err != nil {
+		writeErr(w, log, op, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// List handles GET /reactions?comment_id=...&viewer_id=..., returning every
+// reaction left on a comment visible to viewer_id.
+func (h Handler) List(w http.ResponseWriter, r *http.Request) {
+	const op = "reactionapi.list"
+	log := h.log.With(slog.String("op", op))
+
+	commentID := r.URL.Query().Get("comment_id")
+	viewerID, err := strconv.ParseInt(r.URL.Query().Get("viewer_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	reactions, err := h.service.ListReactions(r.Context(), commentID, viewerID)
+	if err != nil {
+		writeErr(w, log, op, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(reactions); err != nil {
+		log.Error(op, logger.Err(err))
+	}
+}
+
+func writeErr(w http.ResponseWriter, log *slog.Logger, op string, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidEmoji), errors.Is(err, domain.ErrInvalidArg):
+		w.WriteHeader(http.StatusBadRequest)
+	case errors.Is(err, domain.ErrNotFound), errors.Is(err, domain.ErrCommentNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	case errors.Is(err, domain.ErrForbidden):
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		log.Error(op, logger.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
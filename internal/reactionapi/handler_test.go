@@ -0,0 +1,104 @@
+package reactionapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+type fakeService struct {
+	added     bool
+	reactions []domain.Reaction
+	err       error
+}
+
+func (f *fakeService) AddReaction(context.Context, string, int64, string) error {
+	f.added = true
+	return f.err
+}
+
+func (f *fakeService) RemoveReaction(context.Context, string, int64, string) error {
+	return f.err
+}
+
+func (f *fakeService) ListReactions(context.Context, string, int64) ([]domain.Reaction, error) {
+	return f.reactions, f.err
+}
+
+func newTestHandler(svc *fakeService) Handler {
+	return NewHandler(slog.New(slog.NewTextHandler(io.Discard, nil)), svc)
+}
+
+func TestHandlerAdd(t *testing.T) {
+	svc := &fakeService{}
+	h := newTestHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/reactions", strings.NewReader(`{"comment_id":"c1","user_id":1,"emoji":"👍"}`))
+	rec := httptest.NewRecorder()
+
+	h.Add(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+	if !svc.added {
+		t.Fatal("expected AddReaction to be called")
+	}
+}
+
+func TestHandlerAddRejectsInvalidEmoji(t *testing.T) {
+	svc := &fakeService{err: domain.ErrInvalidEmoji}
+	h := newTestHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/reactions", strings.NewReader(`{"comment_id":"c1","user_id":1,"emoji":"bad"}`))
+	rec := httptest.NewRecorder()
+
+	h.Add(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlerListRejectsMissingViewerID(t *testing.T) {
+	svc := &fakeService{}
+	h := newTestHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/reactions?comment_id=c1", nil)
+	rec := httptest.NewRecorder()
+
+	h.List(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlerList(t *testing.T) {
+	svc := &fakeService{reactions: []domain.Reaction{{CommentID: "c1", UserID: 1, Content: "👍"}}}
+	h := newTestHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/reactions?comment_id=c1&viewer_id=1", nil)
+	rec := httptest.NewRecorder()
+
+	h.List(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var got []domain.Reaction
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(got) != 1 || got[0].CommentID != "c1" {
+		t.Fatalf("got %+v, want one reaction for c1", got)
+	}
+}
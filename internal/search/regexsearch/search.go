@@ -0,0 +1,129 @@
+// Package regexsearch implements commentservice.Searcher as the default
+// text-query fallback for a backing store with no dedicated full-text index
+// (Atlas Search, Meilisearch, ...) configured. A store that does support
+// $text natively (Mongo) is expected to run that query itself inside
+// ListPostComments instead of being wrapped here.
+package regexsearch
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+// contextChars is how much of Body surrounds a match in Highlight.
+const contextChars = 40
+
+// Provider lists a post's comments, the subset of commentservice.Provider
+// this Searcher needs.
+type Provider interface {
+	ListPostComments(ctx context.Context, postID string, viewerID int64, filter domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error)
+}
+
+// Searcher filters a post's comments by running a case-insensitive regexp
+// over Body, in place of a real full-text index.
+type Searcher struct {
+	provider Provider
+}
+
+func New(provider Provider) Searcher {
+	return Searcher{provider: provider}
+}
+
+// Search lists every one of postID's comments via Provider, keeps those
+// whose Body matches filter.Query as a case-insensitive substring (setting
+// Highlight to the matched snippet), then paginates the matches. Matching
+// has to run over the full comment set rather than one page of it, or
+// matches outside that page would be silently dropped and Total would
+// undercount the real match count.
+func (s Searcher) Search(ctx context.Context, postID string, viewerID int64, filter domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error) {
+	pattern, err := regexp.Compile("(?i)" + regexp.QuoteMeta(filter.Query))
+	if err != nil {
+		return nil, domain.PaginationMetadata{}, err
+	}
+
+	unbounded := filter
+	unbounded.Query = ""
+	unbounded.Limit = 0
+	unbounded.Offset = 0
+	unbounded.Cursor = ""
+
+	comments, _, err := s.provider.ListPostComments(ctx, postID, viewerID, unbounded)
+	if err != nil {
+		return nil, domain.PaginationMetadata{}, err
+	}
+
+	matched := make([]domain.Comment, 0, len(comments))
+	for _, comment := range comments {
+		loc := pattern.FindStringIndex(comment.Body)
+		if loc == nil {
+			continue
+		}
+		comment.Highlight = highlight(comment.Body, loc)
+		matched = append(matched, comment)
+	}
+
+	page, metadata := paginate(matched, filter)
+	return page, metadata, nil
+}
+
+// paginate slices matched according to filter's cursor/offset and limit,
+// mirroring the offset-vs-cursor precedence domain.Filter documents.
+func paginate(matched []domain.Comment, filter domain.Filter) ([]domain.Comment, domain.PaginationMetadata) {
+	total := len(matched)
+
+	offset := filter.Offset
+	if filter.Cursor != "" {
+		if parsed, err := strconv.Atoi(filter.Cursor); err == nil {
+			offset = parsed
+		}
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > total {
+		offset = total
+	}
+
+	end := total
+	if filter.Limit > 0 && offset+filter.Limit < end {
+		end = offset + filter.Limit
+	}
+
+	metadata := domain.PaginationMetadata{
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: offset,
+	}
+	if end < total {
+		metadata.NextCursor = strconv.Itoa(end)
+	}
+
+	return matched[offset:end], metadata
+}
+
+// highlight returns the snippet of body around the match at loc, trimmed to
+// contextChars on either side.
+func highlight(body string, loc []int) string {
+	start := loc[0] - contextChars
+	if start < 0 {
+		start = 0
+	}
+	end := loc[1] + contextChars
+	if end > len(body) {
+		end = len(body)
+	}
+
+	snippet := body[start:end]
+	if start > 0 {
+		snippet = "…" + strings.TrimLeft(snippet, " ")
+	}
+	if end < len(body) {
+		snippet = strings.TrimRight(snippet, " ") + "…"
+	}
+
+	return snippet
+}
@@ -0,0 +1,114 @@
+package regexsearch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+// fakeProvider honors Limit/Offset the way a real Provider would, so a test
+// that sets a small page size actually exercises pagination instead of
+// always handing Search the full comment set.
+type fakeProvider struct {
+	comments []domain.Comment
+}
+
+func (f fakeProvider) ListPostComments(_ context.Context, _ string, _ int64, filter domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error) {
+	total := len(f.comments)
+
+	offset := filter.Offset
+	if offset > total {
+		offset = total
+	}
+	end := total
+	if filter.Limit > 0 && offset+filter.Limit < end {
+		end = offset + filter.Limit
+	}
+
+	return f.comments[offset:end], domain.PaginationMetadata{Total: total, Limit: filter.Limit, Offset: offset}, nil
+}
+
+func TestSearchFiltersAndHighlightsMatches(t *testing.T) {
+	provider := fakeProvider{comments: []domain.Comment{
+		{ID: "c1", Body: "the quick brown fox jumps over the lazy dog"},
+		{ID: "c2", Body: "nothing relevant here"},
+	}}
+	searcher := New(provider)
+
+	comments, metadata, err := searcher.Search(context.Background(), "post", 1, domain.Filter{Query: "brown FOX"})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	if len(comments) != 1 || comments[0].ID != "c1" {
+		t.Fatalf("comments = %+v, want only c1", comments)
+	}
+	if !strings.Contains(strings.ToLower(comments[0].Highlight), "brown fox") {
+		t.Fatalf("Highlight = %q, want it to contain the match", comments[0].Highlight)
+	}
+	if metadata.Total != 1 {
+		t.Fatalf("Total = %d, want 1", metadata.Total)
+	}
+}
+
+func TestSearchNoMatchesReturnsEmpty(t *testing.T) {
+	provider := fakeProvider{comments: []domain.Comment{{ID: "c1", Body: "nothing relevant"}}}
+	searcher := New(provider)
+
+	comments, _, err := searcher.Search(context.Background(), "post", 1, domain.Filter{Query: "zzz"})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(comments) != 0 {
+		t.Fatalf("comments = %+v, want none", comments)
+	}
+}
+
+// TestSearchMatchesBeyondCallerPageAndPaginatesFiltered guards against
+// Search fetching only the caller's requested page before filtering: with
+// matches scattered across a comment set larger than that page, every match
+// must still be found, Total must count all of them, and the caller's own
+// Limit/Offset must apply to the filtered results, not the unfiltered ones.
+func TestSearchMatchesBeyondCallerPageAndPaginatesFiltered(t *testing.T) {
+	comments := make([]domain.Comment, 0, 10)
+	for i := 0; i < 10; i++ {
+		body := "irrelevant filler"
+		if i%2 == 0 {
+			body = "contains the target word"
+		}
+		comments = append(comments, domain.Comment{ID: fmt.Sprintf("c%d", i), Body: body})
+	}
+	provider := fakeProvider{comments: comments}
+	searcher := New(provider)
+
+	// Ask for a page far smaller than the full comment set, as a caller
+	// fetching the first page of a "deep result set" would.
+	page, metadata, err := searcher.Search(context.Background(), "post", 1, domain.Filter{Query: "target", Limit: 2, Offset: 0})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	if metadata.Total != 5 {
+		t.Fatalf("Total = %d, want 5 (all matches across the full comment set)", metadata.Total)
+	}
+	if len(page) != 2 {
+		t.Fatalf("page = %+v, want 2 results (Limit applied to the filtered set)", page)
+	}
+	if metadata.NextCursor == "" {
+		t.Fatal("expected a NextCursor since more matches remain")
+	}
+
+	next, nextMetadata, err := searcher.Search(context.Background(), "post", 1, domain.Filter{Query: "target", Limit: 2, Cursor: metadata.NextCursor})
+	if err != nil {
+		t.Fatalf("Search() with cursor error = %v", err)
+	}
+	if len(next) != 2 {
+		t.Fatalf("next page = %+v, want 2 results", next)
+	}
+	if nextMetadata.Total != 5 {
+		t.Fatalf("Total on next page = %d, want 5", nextMetadata.Total)
+	}
+}
@@ -0,0 +1,174 @@
+package commentservice
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+//go:generate mockery --name AttachmentStore
+type AttachmentStore interface {
+	Upload(ctx context.Context, id string, fileName string, content io.Reader, mimeType string, size int64) (domain.Attachment, error)
+	Delete(ctx context.Context, attachmentID string) error
+	SignedURL(ctx context.Context, attachmentID string, ttl time.Duration) (string, error)
+}
+
+// UploadAttachment validates and uploads a file, returning it unlinked to any
+// comment. Its ID is the token a later Create/Reply/AttachToComment call uses
+// to link it.
+func (s Service) UploadAttachment(ctx context.Context, dto UploadAttachmentDTO) (domain.Attachment, error) {
+	const op = "service.comment.upload_attachment"
+	log := s.log.With(slog.String("op", op))
+
+	if err := s.validateAttachment(dto.MimeType, dto.Size); err != nil {
+		return domain.Attachment{}, err
+	}
+
+	attachment, err := s.attachmentStore.Upload(ctx, domain.NewID(), dto.FileName, dto.Content, dto.MimeType, dto.Size)
+	if err != nil {
+		return domain.Attachment{}, handleErr(log, op, err)
+	}
+
+	return attachment, nil
+}
+
+// AttachToComment links an already-uploaded attachment to an existing
+// comment, reusing the same ownership/moderation check as Update and Delete.
+func (s Service) AttachToComment(ctx context.Context, dto AttachCommentDTO) (domain.Comment, error) {
+	const op = "service.comment.attach_to_comment"
+	log := s.log.With(slog.String("op", op))
+
+	comment, err := s.provider.GetComment(ctx, dto.CommentID)
+	if err != nil {
+		return domain.Comment{}, handleErr(log, op, err)
+	}
+
+	canView, err := s.permissioner.CanViewPost(ctx, dto.UserID, comment.PostID)
+	if err != nil {
+		return domain.Comment{}, handleErr(log, op, err)
+	}
+	if !canView {
+		return domain.Comment{}, domain.ErrNotFound
+	}
+
+	if _, err := s.authorizeWrite(ctx, comment, dto.UserID); err != nil {
+		return domain.Comment{}, handleErr(log, op, err)
+	}
+
+	if s.maxAttachments > 0 && len(comment.Attachments) >= s.maxAttachments {
+		return domain.Comment{}, domain.ErrTooManyAttachments
+	}
+
+	comment.Attachments = append(comment.Attachments, domain.Attachment{ID: dto.AttachmentID, CommentID: comment.ID})
+	comment.UpdatedAt = time.Now()
+
+	updatedComment, err := s.updater.UpdateComment(ctx, comment)
+	if err != nil {
+		return domain.Comment{}, handleErr(log, op, err)
+	}
+
+	return updatedComment, nil
+}
+
+// DetachFromComment unlinks and deletes an attachment from a comment.
+func (s Service) DetachFromComment(ctx context.Context, dto DetachAttachmentDTO) error {
+	const op = "service.comment.detach_from_comment"
+	log := s.log.With(slog.String("op", op))
+
+	comment, err := s.provider.GetComment(ctx, dto.CommentID)
+	if err != nil {
+		return handleErr(log, op, err)
+	}
+
+	canView, err := s.permissioner.CanViewPost(ctx, dto.UserID, comment.PostID)
+	if err != nil {
+		return handleErr(log, op, err)
+	}
+	if !canView {
+		return domain.ErrNotFound
+	}
+
+	if _, err := s.authorizeWrite(ctx, comment, dto.UserID); err != nil {
+		return handleErr(log, op, err)
+	}
+
+	idx := -1
+	for i, a := range comment.Attachments {
+		if a.ID == dto.AttachmentID {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return domain.ErrAttachmentNotFound
+	}
+
+	if err := s.attachmentStore.Delete(ctx, dto.AttachmentID); err != nil {
+		return handleErr(log, op, err)
+	}
+
+	comment.Attachments = append(comment.Attachments[:idx], comment.Attachments[idx+1:]...)
+	comment.UpdatedAt = time.Now()
+
+	if _, err := s.updater.UpdateComment(ctx, comment); err != nil {
+		return handleErr(log, op, err)
+	}
+
+	return nil
+}
+
+// ListAttachments returns the attachments linked to a comment, reusing the
+// same view-permission check as GetByID.
+func (s Service) ListAttachments(ctx context.Context, commentID string, viewerID int64) ([]domain.Attachment, error) {
+	const op = "service.comment.list_attachments"
+	log := s.log.With(slog.String("op", op))
+
+	comment, err := s.provider.GetComment(ctx, commentID)
+	if err != nil {
+		return nil, handleErr(log, op, err)
+	}
+
+	canView, err := s.permissioner.CanViewPost(ctx, viewerID, comment.PostID)
+	if err != nil {
+		return nil, handleErr(log, op, err)
+	}
+	if !canView {
+		return nil, domain.ErrNotFound
+	}
+
+	return comment.Attachments, nil
+}
+
+func (s Service) validateAttachment(mimeType string, size int64) error {
+	if s.maxAttachmentSize > 0 && size > s.maxAttachmentSize {
+		return domain.ErrAttachmentTooLarge
+	}
+
+	if len(s.allowedAttachmentMimeTypes) == 0 {
+		return nil
+	}
+
+	for _, allowed := range s.allowedAttachmentMimeTypes {
+		if allowed == mimeType {
+			return nil
+		}
+	}
+
+	return domain.ErrUnsupportedMimeType
+}
+
+func attachmentsFromTokens(tokens []string) []domain.Attachment {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	attachments := make([]domain.Attachment, len(tokens))
+	for i, token := range tokens {
+		attachments[i] = domain.Attachment{ID: token}
+	}
+
+	return attachments
+}
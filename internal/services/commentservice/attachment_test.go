@@ -0,0 +1,47 @@
+package commentservice
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+type denyViewPermissioner struct{}
+
+func (denyViewPermissioner) CanViewPost(context.Context, int64, string) (bool, error) {
+	return false, nil
+}
+
+func (denyViewPermissioner) CanModerateComment(context.Context, int64, domain.Comment) (domain.Role, error) {
+	return domain.RoleNone, nil
+}
+
+// TestAttachmentMethodsMaskInvisibleComments guards against AttachToComment,
+// DetachFromComment and ListAttachments leaking the existence of a comment on
+// a post the caller can't view via ErrForbidden instead of ErrNotFound.
+func TestAttachmentMethodsMaskInvisibleComments(t *testing.T) {
+	comment := domain.Comment{ID: "c1", PostID: "post", Path: "/c1"}
+	provider := &fakeProvider{comments: map[string]domain.Comment{"c1": comment}}
+
+	svc := New(Config{
+		Logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Provider:     provider,
+		UserProvider: fakeUserProvider{},
+		Permissioner: denyViewPermissioner{},
+	})
+
+	if _, err := svc.AttachToComment(context.Background(), AttachCommentDTO{CommentID: "c1", UserID: 1, AttachmentID: "a1"}); err != domain.ErrNotFound {
+		t.Fatalf("AttachToComment() error = %v, want ErrNotFound", err)
+	}
+
+	if err := svc.DetachFromComment(context.Background(), DetachAttachmentDTO{CommentID: "c1", UserID: 1, AttachmentID: "a1"}); err != domain.ErrNotFound {
+		t.Fatalf("DetachFromComment() error = %v, want ErrNotFound", err)
+	}
+
+	if _, err := svc.ListAttachments(context.Background(), "c1", 1); err != domain.ErrNotFound {
+		t.Fatalf("ListAttachments() error = %v, want ErrNotFound", err)
+	}
+}
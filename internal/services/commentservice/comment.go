@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
@@ -11,27 +12,64 @@ import (
 )
 
 type Config struct {
-	Logger       *slog.Logger
-	Provider     Provider
-	Creator      Creator
-	Updater      Updater
-	Deleter      Deleter
-	UserProvider UserProvider
+	Logger          *slog.Logger
+	Provider        Provider
+	Creator         Creator
+	Updater         Updater
+	Deleter         Deleter
+	UserProvider    UserProvider
+	Reactor         Reactor
+	Permissioner    Permissioner
+	AttachmentStore AttachmentStore
+	EventPublisher  EventPublisher
+	Federator       Federator
+	Searcher        Searcher
+
+	// MaxReplyDepth caps how deep a reply thread can nest, 0 means unlimited.
+	MaxReplyDepth int
+	// AllowedEmoji restricts which emoji can be used as a reaction, empty
+	// means any emoji is allowed.
+	AllowedEmoji []string
+
+	// AllowedAttachmentMimeTypes restricts uploadable attachment types, empty
+	// means any mime type is allowed.
+	AllowedAttachmentMimeTypes []string
+	// MaxAttachmentSize caps an attachment's size in bytes, 0 means unlimited.
+	MaxAttachmentSize int64
+	// MaxAttachments caps how many attachments a single comment can carry,
+	// 0 means unlimited.
+	MaxAttachments int
 }
 
 type Service struct {
-	log          *slog.Logger
-	provider     Provider
-	creator      Creator
-	updater      Updater
-	deleter      Deleter
-	userProvider UserProvider
+	log             *slog.Logger
+	provider        Provider
+	creator         Creator
+	updater         Updater
+	deleter         Deleter
+	userProvider    UserProvider
+	reactor         Reactor
+	permissioner    Permissioner
+	attachmentStore AttachmentStore
+	eventPublisher  EventPublisher
+	federator       Federator
+	searcher        Searcher
+	maxReplyDepth   int
+	allowedEmoji    []string
+
+	allowedAttachmentMimeTypes []string
+	maxAttachmentSize          int64
+	maxAttachments             int
 }
 
 //go:generate mockery --name Provider
 type Provider interface {
 	GetComment(ctx context.Context, commentID string) (domain.Comment, error)
-	ListPostComments(ctx context.Context, postID string, filter domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error)
+	// ListPostComments/ListReplies take viewerID so an implementation can
+	// hydrate domain.Comment.MyReactions for the requesting viewer alongside
+	// the listing, rather than with one reaction query per comment.
+	ListPostComments(ctx context.Context, postID string, viewerID int64, filter domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error)
+	ListReplies(ctx context.Context, commentID string, viewerID int64, filter domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error)
 }
 
 //go:generate mockery --name Creator
@@ -47,21 +85,39 @@ type Updater interface {
 //go:generate mockery --name Deleter
 type Deleter interface {
 	DeleteComment(ctx context.Context, commentID string) error
+	// TombstoneComment soft-deletes a comment, blanking its body while keeping
+	// the node (and its Path/RootID) in place so replies stay reachable.
+	// moderatorID is recorded on the tombstone when a moderator, rather than
+	// the comment's own author, performed the deletion.
+	TombstoneComment(ctx context.Context, commentID string, moderatorID *int64) error
 }
 
 //go:generate mockery --name UserProvider
 type UserProvider interface {
 	GetUser(ctx context.Context, id int64) (domain.User, error)
+	GetUserByHandle(ctx context.Context, handle string) (domain.User, error)
 }
 
 func New(config Config) Service {
 	return Service{
-		log:          config.Logger,
-		provider:     config.Provider,
-		creator:      config.Creator,
-		updater:      config.Updater,
-		deleter:      config.Deleter,
-		userProvider: config.UserProvider,
+		log:           config.Logger,
+		provider:      config.Provider,
+		creator:       config.Creator,
+		updater:       config.Updater,
+		deleter:       config.Deleter,
+		userProvider:  config.UserProvider,
+		reactor:       config.Reactor,
+		permissioner:  config.Permissioner,
+		maxReplyDepth: config.MaxReplyDepth,
+		allowedEmoji:  config.AllowedEmoji,
+
+		attachmentStore:            config.AttachmentStore,
+		eventPublisher:             config.EventPublisher,
+		federator:                  config.Federator,
+		searcher:                   config.Searcher,
+		allowedAttachmentMimeTypes: config.AllowedAttachmentMimeTypes,
+		maxAttachmentSize:          config.MaxAttachmentSize,
+		maxAttachments:             config.MaxAttachments,
 	}
 }
 
@@ -69,23 +125,122 @@ func (s Service) Create(ctx context.Context, comment CreateCommentDTO) (domain.C
 	const op = "service.comment.create"
 	log := s.log.With(slog.String("op", op))
 
+	canView, err := s.permissioner.CanViewPost(ctx, comment.UserID, comment.PostID)
+	if err != nil {
+		return domain.Comment{}, handleErr(log, op, err)
+	}
+	if !canView {
+		return domain.Comment{}, domain.ErrNotFound
+	}
+
+	if s.maxAttachments > 0 && len(comment.AttachmentTokens) > s.maxAttachments {
+		return domain.Comment{}, domain.ErrTooManyAttachments
+	}
+
 	user, err := s.userProvider.GetUser(ctx, comment.UserID)
 	if err != nil {
 		return domain.Comment{}, handleErr(log, op, err)
 	}
 
+	mentions := s.resolveMentions(ctx, comment.Body)
+
+	id := domain.NewID()
 	createdComment, err := s.creator.CreateComment(ctx, domain.Comment{
-		ID:        domain.NewID(),
-		PostID:    comment.PostID,
+		ID:          id,
+		PostID:      comment.PostID,
+		Path:        "/" + id,
+		User:        user,
+		Body:        comment.Body,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+		Attachments: attachmentsFromTokens(comment.AttachmentTokens),
+		Mentions:    mentions,
+	})
+	if err != nil {
+		return domain.Comment{}, handleErr(log, op, err)
+	}
+
+	s.publishEvent(ctx, log, domain.EventCommentCreated, createdComment, mentionIDs(mentions))
+	if len(mentions) > 0 {
+		s.publishEvent(ctx, log, domain.EventCommentMentioned, createdComment, mentionIDs(mentions))
+	}
+	s.emitFederated(ctx, log, createdComment)
+
+	return createdComment, nil
+}
+
+// Reply creates a comment nested under an existing comment on the same post.
+// The new comment's Path is the parent's Path with its own ID appended, so a
+// subtree can be fetched with a prefix match instead of walking parent links.
+func (s Service) Reply(ctx context.Context, dto ReplyCommentDTO) (domain.Comment, error) {
+	const op = "service.comment.reply"
+	log := s.log.With(slog.String("op", op))
+
+	canView, err := s.permissioner.CanViewPost(ctx, dto.UserID, dto.PostID)
+	if err != nil {
+		return domain.Comment{}, handleErr(log, op, err)
+	}
+	if !canView {
+		return domain.Comment{}, domain.ErrNotFound
+	}
+
+	user, err := s.userProvider.GetUser(ctx, dto.UserID)
+	if err != nil {
+		return domain.Comment{}, handleErr(log, op, err)
+	}
+
+	parent, err := s.provider.GetComment(ctx, dto.ParentID)
+	if err != nil {
+		return domain.Comment{}, handleErr(log, op, err)
+	}
+
+	if parent.PostID != dto.PostID {
+		return domain.Comment{}, domain.ErrInvalidArg
+	}
+
+	// parent.Path includes parent's own ID, so its depth (ancestor count) is
+	// one less than its segment count.
+	parentDepth := strings.Count(parent.Path, "/") - 1
+	if s.maxReplyDepth > 0 && parentDepth >= s.maxReplyDepth {
+		return domain.Comment{}, domain.ErrMaxDepthExceeded
+	}
+
+	rootID := parent.RootID
+	if rootID == "" {
+		rootID = parent.ID
+	}
+
+	id := domain.NewID()
+	path := parent.Path
+	if path == "" {
+		path = "/" + parent.ID
+	}
+	path += "/" + id
+
+	mentions := s.resolveMentions(ctx, dto.Body)
+
+	createdComment, err := s.creator.CreateComment(ctx, domain.Comment{
+		ID:        id,
+		PostID:    dto.PostID,
+		ParentID:  parent.ID,
+		RootID:    rootID,
+		Path:      path,
 		User:      user,
-		Body:      comment.Body,
+		Body:      dto.Body,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
+		Mentions:  mentions,
 	})
 	if err != nil {
 		return domain.Comment{}, handleErr(log, op, err)
 	}
 
+	s.publishEvent(ctx, log, domain.EventCommentCreated, createdComment, mentionIDs(mentions))
+	if len(mentions) > 0 {
+		s.publishEvent(ctx, log, domain.EventCommentMentioned, createdComment, mentionIDs(mentions))
+	}
+	s.emitFederated(ctx, log, createdComment)
+
 	return createdComment, nil
 }
 
@@ -98,18 +253,44 @@ func (s Service) Update(ctx context.Context, dto UpdateCommentDTO) (domain.Comme
 		return domain.Comment{}, handleErr(log, op, err)
 	}
 
-	if comment.User.ID != dto.UserID {
-		return domain.Comment{}, domain.ErrUnauthorized
+	canView, err := s.permissioner.CanViewPost(ctx, dto.UserID, comment.PostID)
+	if err != nil {
+		return domain.Comment{}, handleErr(log, op, err)
+	}
+	if !canView {
+		return domain.Comment{}, domain.ErrNotFound
+	}
+
+	moderatorID, err := s.authorizeWrite(ctx, comment, dto.UserID)
+	if err != nil {
+		return domain.Comment{}, handleErr(log, op, err)
+	}
+
+	if dto.AttachmentTokens != nil {
+		if s.maxAttachments > 0 && len(dto.AttachmentTokens) > s.maxAttachments {
+			return domain.Comment{}, domain.ErrTooManyAttachments
+		}
+		comment.Attachments = attachmentsFromTokens(dto.AttachmentTokens)
 	}
 
+	mentions := s.resolveMentions(ctx, dto.Body)
+	added := newMentions(comment.Mentions, mentions)
+
+	comment.ModeratorID = moderatorID
 	comment.Body = dto.Body
 	comment.UpdatedAt = time.Now()
+	comment.Mentions = mentions
 
 	updatedComment, err := s.updater.UpdateComment(ctx, comment)
 	if err != nil {
 		return domain.Comment{}, handleErr(log, op, err)
 	}
 
+	s.publishEvent(ctx, log, domain.EventCommentUpdated, updatedComment, mentionIDs(mentions))
+	if len(added) > 0 {
+		s.publishEvent(ctx, log, domain.EventCommentMentioned, updatedComment, mentionIDs(added))
+	}
+
 	return updatedComment, nil
 }
 
@@ -122,8 +303,30 @@ func (s Service) Delete(ctx context.Context, dto DeleteCommentDTO) error {
 		return handleErr(log, op, err)
 	}
 
-	if comment.User.ID != dto.UserID {
-		return domain.ErrUnauthorized
+	canView, err := s.permissioner.CanViewPost(ctx, dto.UserID, comment.PostID)
+	if err != nil {
+		return handleErr(log, op, err)
+	}
+	if !canView {
+		return domain.ErrNotFound
+	}
+
+	moderatorID, err := s.authorizeWrite(ctx, comment, dto.UserID)
+	if err != nil {
+		return handleErr(log, op, err)
+	}
+
+	// A comment with replies is tombstoned rather than removed so the
+	// subtree stays reachable, mirroring how issue trackers preserve
+	// discussion context when a parent comment is deleted.
+	comment.DeletedByModerator = moderatorID != nil
+
+	if comment.ChildCount > 0 {
+		if err := s.deleter.TombstoneComment(ctx, dto.CommentID, moderatorID); err != nil {
+			return handleErr(log, op, err)
+		}
+		s.publishEvent(ctx, log, domain.EventCommentDeleted, comment, nil)
+		return nil
 	}
 
 	err = s.deleter.DeleteComment(ctx, dto.CommentID)
@@ -131,10 +334,12 @@ func (s Service) Delete(ctx context.Context, dto DeleteCommentDTO) error {
 		return handleErr(log, op, err)
 	}
 
+	s.publishEvent(ctx, log, domain.EventCommentDeleted, comment, nil)
+
 	return nil
 }
 
-func (s Service) GetByID(ctx context.Context, id string) (domain.Comment, error) {
+func (s Service) GetByID(ctx context.Context, id string, viewerID int64) (domain.Comment, error) {
 	const op = "service.comment.get_by_id"
 	log := s.log.With(slog.String("op", op))
 
@@ -143,14 +348,45 @@ func (s Service) GetByID(ctx context.Context, id string) (domain.Comment, error)
 		return domain.Comment{}, handleErr(log, op, err)
 	}
 
+	canView, err := s.permissioner.CanViewPost(ctx, viewerID, comment.PostID)
+	if err != nil {
+		return domain.Comment{}, handleErr(log, op, err)
+	}
+	if !canView {
+		return domain.Comment{}, domain.ErrNotFound
+	}
+
 	return comment, nil
 }
 
-func (s Service) ListByPostID(ctx context.Context, postID string, filter domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error) {
+// ListByPostID lists the top-level comments of a post. When filter.TreeMode is
+// set, the provider nests each comment's replies under Children, paginated by
+// filter.ChildLimit, instead of returning a flat list. Each comment's
+// Reactions/MyReactions are expected to be hydrated by the provider in the
+// same storage call, rather than with one reaction query per comment. When
+// filter.Query is set and a Searcher is configured, it runs the text query
+// instead of the provider's own regex/$text fallback.
+func (s Service) ListByPostID(ctx context.Context, postID string, viewerID int64, filter domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error) {
 	const op = "service.comment.list_by_post_id"
 	log := s.log.With(slog.String("op", op))
 
-	comments, metadata, err := s.provider.ListPostComments(ctx, postID, filter)
+	canView, err := s.permissioner.CanViewPost(ctx, viewerID, postID)
+	if err != nil {
+		return nil, domain.PaginationMetadata{}, handleErr(log, op, err)
+	}
+	if !canView {
+		return nil, domain.PaginationMetadata{}, domain.ErrNotFound
+	}
+
+	if filter.Query != "" && s.searcher != nil {
+		comments, metadata, err := s.searcher.Search(ctx, postID, viewerID, filter)
+		if err != nil {
+			return nil, domain.PaginationMetadata{}, handleErr(log, op, err)
+		}
+		return comments, metadata, nil
+	}
+
+	comments, metadata, err := s.provider.ListPostComments(ctx, postID, viewerID, filter)
 	if err != nil {
 		return nil, domain.PaginationMetadata{}, handleErr(log, op, err)
 	}
@@ -158,6 +394,52 @@ func (s Service) ListByPostID(ctx context.Context, postID string, filter domain.
 	return comments, metadata, nil
 }
 
+// ListReplies lazily loads a page of a comment's direct replies, for
+// expanding a branch that ListByPostID's TreeMode didn't fully hydrate.
+func (s Service) ListReplies(ctx context.Context, commentID string, viewerID int64, filter domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error) {
+	const op = "service.comment.list_replies"
+	log := s.log.With(slog.String("op", op))
+
+	comment, err := s.provider.GetComment(ctx, commentID)
+	if err != nil {
+		return nil, domain.PaginationMetadata{}, handleErr(log, op, err)
+	}
+
+	canView, err := s.permissioner.CanViewPost(ctx, viewerID, comment.PostID)
+	if err != nil {
+		return nil, domain.PaginationMetadata{}, handleErr(log, op, err)
+	}
+	if !canView {
+		return nil, domain.PaginationMetadata{}, domain.ErrNotFound
+	}
+
+	replies, metadata, err := s.provider.ListReplies(ctx, commentID, viewerID, filter)
+	if err != nil {
+		return nil, domain.PaginationMetadata{}, handleErr(log, op, err)
+	}
+
+	return replies, metadata, nil
+}
+
+// authorizeWrite checks whether userID may modify comment: either as its
+// author, or as a moderator per the Permissioner. It returns the moderator ID
+// to record on the comment, nil when the author acted on their own.
+func (s Service) authorizeWrite(ctx context.Context, comment domain.Comment, userID int64) (*int64, error) {
+	if comment.User.ID == userID {
+		return nil, nil
+	}
+
+	role, err := s.permissioner.CanModerateComment(ctx, userID, comment)
+	if err != nil {
+		return nil, err
+	}
+	if role == domain.RoleNone {
+		return nil, domain.ErrForbidden
+	}
+
+	return &userID, nil
+}
+
 func handleErr(log *slog.Logger, op string, err error) error {
 	switch {
 	case errors.Is(err, domain.ErrInvalidID):
@@ -166,6 +448,10 @@ func handleErr(log *slog.Logger, op string, err error) error {
 		return err
 	case errors.Is(err, domain.ErrInvalidArg):
 		return err
+	case errors.Is(err, domain.ErrMaxDepthExceeded):
+		return err
+	case errors.Is(err, domain.ErrForbidden), errors.Is(err, domain.ErrNotFound):
+		return err
 	default:
 		log.Error(op, logger.Err(err))
 		return domain.ErrInternal
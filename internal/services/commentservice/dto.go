@@ -0,0 +1,53 @@
+package commentservice
+
+import "io"
+
+type CreateCommentDTO struct {
+	PostID string
+	UserID int64
+	Body   string
+
+	// AttachmentTokens are IDs returned by a prior UploadAttachment call.
+	AttachmentTokens []string
+}
+
+type UpdateCommentDTO struct {
+	CommentID string
+	UserID    int64
+	Body      string
+
+	// AttachmentTokens are IDs returned by a prior UploadAttachment call.
+	AttachmentTokens []string
+}
+
+type DeleteCommentDTO struct {
+	CommentID string
+	UserID    int64
+}
+
+type ReplyCommentDTO struct {
+	PostID   string
+	ParentID string
+	UserID   int64
+	Body     string
+}
+
+type UploadAttachmentDTO struct {
+	UserID   int64
+	FileName string
+	MimeType string
+	Size     int64
+	Content  io.Reader
+}
+
+type AttachCommentDTO struct {
+	CommentID    string
+	UserID       int64
+	AttachmentID string
+}
+
+type DetachAttachmentDTO struct {
+	CommentID    string
+	UserID       int64
+	AttachmentID string
+}
@@ -0,0 +1,56 @@
+package commentservice
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+	"github.com/ARUMANDESU/uniclubs-comments-service/pkg/logger"
+)
+
+//go:generate mockery --name EventPublisher
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.Event) error
+}
+
+// Federator emits an outbound ActivityPub activity for a locally created
+// comment, so federated followers of the post see it. It's implemented by
+// internal/federation.Outbox.
+//
+//go:generate mockery --name Federator
+type Federator interface {
+	EmitCreate(ctx context.Context, comment domain.Comment) error
+}
+
+// publishEvent emits an event after its triggering storage write has already
+// succeeded (outbox-style), so a publish failure is logged rather than
+// returned to the caller.
+func (s Service) publishEvent(ctx context.Context, log *slog.Logger, eventType domain.EventType, comment domain.Comment, notifiedUsers []int64) {
+	if s.eventPublisher == nil {
+		return
+	}
+
+	err := s.eventPublisher.Publish(ctx, domain.Event{
+		ID:            domain.NewID(),
+		Type:          eventType,
+		Comment:       comment,
+		NotifiedUsers: notifiedUsers,
+		OccurredAt:    time.Now(),
+	})
+	if err != nil {
+		log.Error("failed to publish event", logger.Err(err), slog.String("event_type", string(eventType)))
+	}
+}
+
+// emitFederated delivers a newly created comment to federation, the same
+// fire-and-forget way publishEvent does.
+func (s Service) emitFederated(ctx context.Context, log *slog.Logger, comment domain.Comment) {
+	if s.federator == nil {
+		return
+	}
+
+	if err := s.federator.EmitCreate(ctx, comment); err != nil {
+		log.Error("failed to emit federated activity", logger.Err(err))
+	}
+}
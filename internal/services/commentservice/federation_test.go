@@ -0,0 +1,48 @@
+package commentservice
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+type recordingFederator struct {
+	emitted []domain.Comment
+}
+
+func (f *recordingFederator) EmitCreate(_ context.Context, comment domain.Comment) error {
+	f.emitted = append(f.emitted, comment)
+	return nil
+}
+
+// TestCreateAndReplyEmitFederatedActivity guards against local comment
+// creation silently skipping the outbound federation delivery that Outbox
+// exists to provide.
+func TestCreateAndReplyEmitFederatedActivity(t *testing.T) {
+	root := domain.Comment{ID: "root", PostID: "post", Path: "/root"}
+	provider := &fakeProvider{comments: map[string]domain.Comment{"root": root}}
+	federator := &recordingFederator{}
+
+	svc := New(Config{
+		Logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Provider:     provider,
+		Creator:      fakeCreator{},
+		UserProvider: fakeUserProvider{},
+		Permissioner: allowAllPermissioner{},
+		Federator:    federator,
+	})
+
+	if _, err := svc.Create(context.Background(), CreateCommentDTO{PostID: "post", UserID: 1, Body: "hi"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := svc.Reply(context.Background(), ReplyCommentDTO{PostID: "post", ParentID: "root", UserID: 1, Body: "hi"}); err != nil {
+		t.Fatalf("Reply() error = %v", err)
+	}
+
+	if len(federator.emitted) != 2 {
+		t.Fatalf("emitted = %d activities, want 2", len(federator.emitted))
+	}
+}
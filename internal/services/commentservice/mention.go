@@ -0,0 +1,88 @@
+package commentservice
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+var mentionPattern = regexp.MustCompile(`@([a-zA-Z0-9_]{2,32})`)
+
+// parseMentionHandles extracts the unique @handles referenced in body, in
+// the order they first appear.
+func parseMentionHandles(body string) []string {
+	matches := mentionPattern.FindAllStringSubmatch(body, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(matches))
+	handles := make([]string, 0, len(matches))
+	for _, m := range matches {
+		handle := m[1]
+		if _, ok := seen[handle]; ok {
+			continue
+		}
+		seen[handle] = struct{}{}
+		handles = append(handles, handle)
+	}
+
+	return handles
+}
+
+// resolveMentions parses body and resolves each @handle through the
+// UserProvider, silently skipping handles that don't match a known user.
+func (s Service) resolveMentions(ctx context.Context, body string) []domain.User {
+	handles := parseMentionHandles(body)
+	if len(handles) == 0 {
+		return nil
+	}
+
+	users := make([]domain.User, 0, len(handles))
+	for _, handle := range handles {
+		user, err := s.userProvider.GetUserByHandle(ctx, handle)
+		if err != nil {
+			continue
+		}
+		users = append(users, user)
+	}
+
+	return users
+}
+
+func mentionIDs(users []domain.User) []int64 {
+	if len(users) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+
+	return ids
+}
+
+// newMentions returns the users in current that aren't present in previous,
+// so an update only notifies newly-added mentions.
+func newMentions(previous, current []domain.User) []domain.User {
+	if len(current) == 0 {
+		return nil
+	}
+
+	seen := make(map[int64]struct{}, len(previous))
+	for _, u := range previous {
+		seen[u.ID] = struct{}{}
+	}
+
+	var added []domain.User
+	for _, u := range current {
+		if _, ok := seen[u.ID]; ok {
+			continue
+		}
+		added = append(added, u)
+	}
+
+	return added
+}
@@ -0,0 +1,85 @@
+package commentservice
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+type handleUserProvider struct {
+	byHandle map[string]domain.User
+}
+
+func (h handleUserProvider) GetUser(_ context.Context, id int64) (domain.User, error) {
+	return domain.User{ID: id}, nil
+}
+
+func (h handleUserProvider) GetUserByHandle(_ context.Context, handle string) (domain.User, error) {
+	user, ok := h.byHandle[handle]
+	if !ok {
+		return domain.User{}, domain.ErrUserNotFound
+	}
+	return user, nil
+}
+
+type recordingPublisher struct {
+	events []domain.Event
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, event domain.Event) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+// TestReplyResolvesMentionsAndPublishesEvents guards against Reply silently
+// skipping the mention/outbox pipeline that Create and Update go through.
+func TestReplyResolvesMentionsAndPublishesEvents(t *testing.T) {
+	root := domain.Comment{ID: "root", PostID: "post", Path: "/root"}
+	provider := &fakeProvider{comments: map[string]domain.Comment{"root": root}}
+	publisher := &recordingPublisher{}
+
+	svc := New(Config{
+		Logger:         slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Provider:       provider,
+		Creator:        fakeCreator{},
+		UserProvider:   handleUserProvider{byHandle: map[string]domain.User{"alice": {ID: 42, Handle: "alice"}}},
+		Permissioner:   allowAllPermissioner{},
+		EventPublisher: publisher,
+	})
+
+	reply, err := svc.Reply(context.Background(), ReplyCommentDTO{
+		PostID:   "post",
+		ParentID: "root",
+		UserID:   1,
+		Body:     "hey @alice check this out",
+	})
+	if err != nil {
+		t.Fatalf("Reply() error = %v", err)
+	}
+
+	if len(reply.Mentions) != 1 || reply.Mentions[0].ID != 42 {
+		t.Fatalf("Mentions = %+v, want [{ID:42}]", reply.Mentions)
+	}
+
+	var gotCreated, gotMentioned bool
+	for _, e := range publisher.events {
+		switch e.Type {
+		case domain.EventCommentCreated:
+			gotCreated = true
+		case domain.EventCommentMentioned:
+			gotMentioned = true
+			if len(e.NotifiedUsers) != 1 || e.NotifiedUsers[0] != 42 {
+				t.Fatalf("NotifiedUsers = %v, want [42]", e.NotifiedUsers)
+			}
+		}
+	}
+	if !gotCreated {
+		t.Fatal("expected a comment.created event to be published")
+	}
+	if !gotMentioned {
+		t.Fatal("expected a comment.mentioned event to be published")
+	}
+}
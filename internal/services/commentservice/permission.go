@@ -0,0 +1,17 @@
+package commentservice
+
+import (
+	"context"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+//go:generate mockery --name Permissioner
+type Permissioner interface {
+	// CanViewPost reports whether userID may see postID, taking the post's
+	// privacy settings into account.
+	CanViewPost(ctx context.Context, userID int64, postID string) (bool, error)
+	// CanModerateComment reports the highest role userID holds over comment,
+	// e.g. the club owner or an admin of the post it belongs to.
+	CanModerateComment(ctx context.Context, userID int64, comment domain.Comment) (domain.Role, error)
+}
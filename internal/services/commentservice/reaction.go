@@ -0,0 +1,105 @@
+package commentservice
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+//go:generate mockery --name Reactor
+type Reactor interface {
+	AddReaction(ctx context.Context, commentID string, userID int64, emoji string) error
+	RemoveReaction(ctx context.Context, commentID string, userID int64, emoji string) error
+	ListReactions(ctx context.Context, commentID string) ([]domain.Reaction, error)
+}
+
+// AddReaction records a viewer's emoji reaction to a comment, rejecting
+// emoji outside the configured allowed set. Reuses the same CanViewPost/
+// ErrNotFound check as attachment.go/comment.go so a comment on a post the
+// viewer can't see doesn't leak its existence.
+func (s Service) AddReaction(ctx context.Context, commentID string, userID int64, emoji string) error {
+	const op = "service.comment.add_reaction"
+	log := s.log.With(slog.String("op", op))
+
+	if !s.isAllowedEmoji(emoji) {
+		return domain.ErrInvalidEmoji
+	}
+
+	if err := s.authorizeView(ctx, commentID, userID); err != nil {
+		return handleErr(log, op, err)
+	}
+
+	if err := s.reactor.AddReaction(ctx, commentID, userID, emoji); err != nil {
+		return handleErr(log, op, err)
+	}
+
+	return nil
+}
+
+// RemoveReaction withdraws a viewer's emoji reaction from a comment.
+func (s Service) RemoveReaction(ctx context.Context, commentID string, userID int64, emoji string) error {
+	const op = "service.comment.remove_reaction"
+	log := s.log.With(slog.String("op", op))
+
+	if err := s.authorizeView(ctx, commentID, userID); err != nil {
+		return handleErr(log, op, err)
+	}
+
+	if err := s.reactor.RemoveReaction(ctx, commentID, userID, emoji); err != nil {
+		return handleErr(log, op, err)
+	}
+
+	return nil
+}
+
+// ListReactions returns every reaction left on a comment visible to viewerID.
+func (s Service) ListReactions(ctx context.Context, commentID string, viewerID int64) ([]domain.Reaction, error) {
+	const op = "service.comment.list_reactions"
+	log := s.log.With(slog.String("op", op))
+
+	if err := s.authorizeView(ctx, commentID, viewerID); err != nil {
+		return nil, handleErr(log, op, err)
+	}
+
+	reactions, err := s.reactor.ListReactions(ctx, commentID)
+	if err != nil {
+		return nil, handleErr(log, op, err)
+	}
+
+	return reactions, nil
+}
+
+// authorizeView checks that viewerID can see the post commentID belongs to,
+// returning domain.ErrNotFound (not ErrForbidden) when it can't, so the
+// check doesn't leak the comment's existence.
+func (s Service) authorizeView(ctx context.Context, commentID string, viewerID int64) error {
+	comment, err := s.provider.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	canView, err := s.permissioner.CanViewPost(ctx, viewerID, comment.PostID)
+	if err != nil {
+		return err
+	}
+	if !canView {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s Service) isAllowedEmoji(emoji string) bool {
+	if len(s.allowedEmoji) == 0 {
+		return true
+	}
+
+	for _, allowed := range s.allowedEmoji {
+		if allowed == emoji {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+package commentservice
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+type fakeReactor struct {
+	added     bool
+	reactions []domain.Reaction
+}
+
+func (f *fakeReactor) AddReaction(context.Context, string, int64, string) error {
+	f.added = true
+	return nil
+}
+
+func (f *fakeReactor) RemoveReaction(context.Context, string, int64, string) error {
+	return nil
+}
+
+func (f *fakeReactor) ListReactions(context.Context, string) ([]domain.Reaction, error) {
+	return f.reactions, nil
+}
+
+// TestReactionMethodsMaskInvisibleComments guards against AddReaction,
+// RemoveReaction and ListReactions leaking the existence of a comment on a
+// post the caller can't view, the same existence leak chunk0-3 closed for
+// Update/Delete and commit 6021b80 closed for the attachment endpoints.
+func TestReactionMethodsMaskInvisibleComments(t *testing.T) {
+	comment := domain.Comment{ID: "c1", PostID: "post"}
+	provider := &fakeProvider{comments: map[string]domain.Comment{"c1": comment}}
+	reactor := &fakeReactor{}
+
+	svc := New(Config{
+		Logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Provider:     provider,
+		Permissioner: denyViewPermissioner{},
+		Reactor:      reactor,
+	})
+
+	if err := svc.AddReaction(context.Background(), "c1", 1, "👍"); err != domain.ErrNotFound {
+		t.Fatalf("AddReaction() error = %v, want ErrNotFound", err)
+	}
+	if err := svc.RemoveReaction(context.Background(), "c1", 1, "👍"); err != domain.ErrNotFound {
+		t.Fatalf("RemoveReaction() error = %v, want ErrNotFound", err)
+	}
+	if _, err := svc.ListReactions(context.Background(), "c1", 1); err != domain.ErrNotFound {
+		t.Fatalf("ListReactions() error = %v, want ErrNotFound", err)
+	}
+	if reactor.added {
+		t.Fatal("AddReaction reached the Reactor despite the view check failing")
+	}
+}
+
+func TestAddReactionSucceedsWhenVisible(t *testing.T) {
+	comment := domain.Comment{ID: "c1", PostID: "post"}
+	provider := &fakeProvider{comments: map[string]domain.Comment{"c1": comment}}
+	reactor := &fakeReactor{}
+
+	svc := New(Config{
+		Logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Provider:     provider,
+		Permissioner: allowAllPermissioner{},
+		Reactor:      reactor,
+	})
+
+	if err := svc.AddReaction(context.Background(), "c1", 1, "👍"); err != nil {
+		t.Fatalf("AddReaction() error = %v", err)
+	}
+	if !reactor.added {
+		t.Fatal("expected Reactor.AddReaction to be called")
+	}
+}
@@ -0,0 +1,108 @@
+package commentservice
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+type fakeProvider struct {
+	comments map[string]domain.Comment
+}
+
+func (f *fakeProvider) GetComment(_ context.Context, commentID string) (domain.Comment, error) {
+	c, ok := f.comments[commentID]
+	if !ok {
+		return domain.Comment{}, domain.ErrCommentNotFound
+	}
+	return c, nil
+}
+
+func (f *fakeProvider) ListPostComments(context.Context, string, int64, domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error) {
+	return nil, domain.PaginationMetadata{}, nil
+}
+
+func (f *fakeProvider) ListReplies(context.Context, string, int64, domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error) {
+	return nil, domain.PaginationMetadata{}, nil
+}
+
+type fakeCreator struct{}
+
+func (fakeCreator) CreateComment(_ context.Context, comment domain.Comment) (domain.Comment, error) {
+	return comment, nil
+}
+
+type fakeUserProvider struct{}
+
+func (fakeUserProvider) GetUser(_ context.Context, id int64) (domain.User, error) {
+	return domain.User{ID: id}, nil
+}
+
+func (fakeUserProvider) GetUserByHandle(context.Context, string) (domain.User, error) {
+	return domain.User{}, domain.ErrUserNotFound
+}
+
+type allowAllPermissioner struct{}
+
+func (allowAllPermissioner) CanViewPost(context.Context, int64, string) (bool, error) {
+	return true, nil
+}
+
+func (allowAllPermissioner) CanModerateComment(context.Context, int64, domain.Comment) (domain.Role, error) {
+	return domain.RoleNone, nil
+}
+
+func newTestService(provider *fakeProvider, maxReplyDepth int) Service {
+	return New(Config{
+		Logger:        slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Provider:      provider,
+		Creator:       fakeCreator{},
+		UserProvider:  fakeUserProvider{},
+		Permissioner:  allowAllPermissioner{},
+		MaxReplyDepth: maxReplyDepth,
+	})
+}
+
+// TestReplyPathIsLinearWithDepth guards against Path accounting a top-level
+// comment as already one level deep: a top-level comment's own Path must
+// include itself, so every reply only adds one "/" segment.
+func TestReplyPathIsLinearWithDepth(t *testing.T) {
+	root := domain.Comment{ID: "root", PostID: "post", Path: "/root"}
+	provider := &fakeProvider{comments: map[string]domain.Comment{"root": root}}
+	svc := newTestService(provider, 0)
+
+	reply, err := svc.Reply(context.Background(), ReplyCommentDTO{
+		PostID:   "post",
+		ParentID: "root",
+		UserID:   1,
+		Body:     "hi",
+	})
+	if err != nil {
+		t.Fatalf("Reply() error = %v", err)
+	}
+
+	if reply.Path != "/root/"+reply.ID {
+		t.Fatalf("Path = %q, want %q", reply.Path, "/root/"+reply.ID)
+	}
+}
+
+func TestReplyEnforcesMaxDepth(t *testing.T) {
+	root := domain.Comment{ID: "root", PostID: "post", Path: "/root"}
+	child := domain.Comment{ID: "child", PostID: "post", ParentID: "root", RootID: "root", Path: "/root/child"}
+	provider := &fakeProvider{comments: map[string]domain.Comment{"root": root, "child": child}}
+
+	// MaxReplyDepth=1 allows replying to the root (depth 1 has 1 slash) but
+	// not replying to a reply that is already at depth 1.
+	svc := newTestService(provider, 1)
+
+	if _, err := svc.Reply(context.Background(), ReplyCommentDTO{PostID: "post", ParentID: "child", UserID: 1, Body: "too deep"}); err != domain.ErrMaxDepthExceeded {
+		t.Fatalf("Reply() error = %v, want ErrMaxDepthExceeded", err)
+	}
+
+	if _, err := svc.Reply(context.Background(), ReplyCommentDTO{PostID: "post", ParentID: "root", UserID: 1, Body: "ok"}); err != nil {
+		t.Fatalf("Reply() to root error = %v, want nil", err)
+	}
+}
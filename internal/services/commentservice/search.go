@@ -0,0 +1,20 @@
+package commentservice
+
+import (
+	"context"
+
+	"github.com/ARUMANDESU/uniclubs-comments-service/internal/domain"
+)
+
+// Searcher runs a text query over a post's comments through an index
+// purpose-built for it (Atlas Search, Meilisearch, ...), as an alternative to
+// the default provider's regex/$text query. See
+// internal/search/regexsearch for the regex fallback used when no such
+// index is configured.
+//
+//go:generate mockery --name Searcher
+type Searcher interface {
+	// viewerID lets an implementation hydrate MyReactions the same way
+	// Provider's listing methods do.
+	Search(ctx context.Context, postID string, viewerID int64, filter domain.Filter) ([]domain.Comment, domain.PaginationMetadata, error)
+}
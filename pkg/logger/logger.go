@@ -0,0 +1,8 @@
+package logger
+
+import "log/slog"
+
+// Err wraps an error as a slog attribute under the conventional "error" key.
+func Err(err error) slog.Attr {
+	return slog.Attr{Key: "error", Value: slog.StringValue(err.Error())}
+}